@@ -9,8 +9,14 @@ import (
 
 // RegisterLegacyAminoCodec registers the vesting interfaces and concrete types on the
 // provided LegacyAmino codec. These types are used for Amino JSON serialization
+//
+// MockVestedDelegateVestingAccount is registered under its own proto name
+// rather than the reused "cosmos-sdk/BaseVestingAccount" string, which
+// collided with the real BaseVestingAccount and would fail to load genesis
+// state containing both. Existing chain state using the old name must run
+// the v047 migration in x/auth/vesting/migrations before upgrading.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
-	cdc.RegisterConcrete(&MockVestedDelegateVestingAccount{}, "cosmos-sdk/BaseVestingAccount", nil)
+	cdc.RegisterConcrete(&MockVestedDelegateVestingAccount{}, "cosmos-sdk/MockVestedDelegateVestingAccount", nil)
 }
 
 // RegisterInterface associates protoName with AccountI and VestingAccount
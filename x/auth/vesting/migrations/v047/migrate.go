@@ -0,0 +1,32 @@
+package v047
+
+import (
+	vestingtestutil "github.com/cosmos/cosmos-sdk/testutil/vesting"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// MigrateMockVestedAccounts replaces every account of the now-deprecated
+// testutil/vesting.MockVestedDelegateVestingAccount type with an equivalent
+// DelegableContinuousVestingAccount wrapping the same underlying
+// ContinuousVestingAccount. The mock account was registered under the
+// reused "cosmos-sdk/BaseVestingAccount" Amino name, which collides with the
+// real BaseVestingAccount; any chain state created with it must run this
+// migration before the codec fix can be deployed.
+func MigrateMockVestedAccounts(ctx sdk.Context, ak authkeeper.AccountKeeper) {
+	var toMigrate []*vestingtestutil.MockVestedDelegateVestingAccount
+
+	ak.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		if mock, ok := account.(*vestingtestutil.MockVestedDelegateVestingAccount); ok {
+			toMigrate = append(toMigrate, mock)
+		}
+		return false
+	})
+
+	for _, mock := range toMigrate {
+		migrated := vestingtypes.NewDelegableContinuousVestingAccount(mock.ContinuousVestingAccount)
+		ak.SetAccount(ctx, migrated)
+	}
+}
@@ -0,0 +1,48 @@
+package v047_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	vestingtestutil "github.com/cosmos/cosmos-sdk/testutil/vesting"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/auth/vesting/migrations/v047"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// TestMigrateMockVestedAccounts checks that every MockVestedDelegateVestingAccount
+// in the account store is replaced by an equivalent DelegableContinuousVestingAccount
+// wrapping the same underlying ContinuousVestingAccount, and that ordinary
+// accounts are left untouched.
+func TestMigrateMockVestedAccounts(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+
+	now := ctx.BlockTime()
+	mockAddr := sdk.AccAddress([]byte("mockVestedAcctAddr__"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	cva := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(mockAddr), origCoins, now.Unix(), now.Add(100*time.Second).Unix())
+	mock := vestingtestutil.NewMockVestedDelegateVestingAccount(cva)
+	app.AccountKeeper.SetAccount(ctx, mock)
+
+	plainAddr := sdk.AccAddress([]byte("ordinaryAccountAddr_"))
+	plain := authtypes.NewBaseAccountWithAddress(plainAddr)
+	app.AccountKeeper.SetAccount(ctx, plain)
+
+	v047.MigrateMockVestedAccounts(ctx, app.AccountKeeper)
+
+	migrated := app.AccountKeeper.GetAccount(ctx, mockAddr)
+	dcva, ok := migrated.(*vestingtypes.DelegableContinuousVestingAccount)
+	require.True(t, ok, "mock account should have been replaced by a DelegableContinuousVestingAccount")
+	require.Equal(t, origCoins, dcva.OriginalVesting)
+	require.True(t, dcva.LockedCoinsFromDelegating(now).IsZero())
+
+	untouched := app.AccountKeeper.GetAccount(ctx, plainAddr)
+	require.IsType(t, &authtypes.BaseAccount{}, untouched)
+}
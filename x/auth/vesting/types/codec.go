@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/gogo/protobuf/proto"
+)
+
+func init() {
+	// Each Delegable*VestingAccount wraps a base vesting account with no new
+	// fields, so without its own registered proto name it would resolve its
+	// Any type URL through the embedded type and collide with it. Registering
+	// these names here - rather than in a generated .pb.go, since these types
+	// are hand-maintained Go wrappers, not proto messages with their own
+	// descriptor - gives each one a stable, distinct identity.
+	proto.RegisterType((*DelegableContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.DelegableContinuousVestingAccount")
+	proto.RegisterType((*DelegablePeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.DelegablePeriodicVestingAccount")
+	proto.RegisterType((*DelegableDelayedVestingAccount)(nil), "cosmos.vesting.v1beta1.DelegableDelayedVestingAccount")
+}
+
+// RegisterLegacyAminoCodec registers the delegable vesting account types on
+// the provided LegacyAmino codec, each under its own stable proto name so
+// that Amino JSON round-trips without colliding with the base vesting types
+// they wrap.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&DelegableContinuousVestingAccount{}, "cosmos-sdk/DelegableContinuousVestingAccount", nil)
+	cdc.RegisterConcrete(&DelegablePeriodicVestingAccount{}, "cosmos-sdk/DelegablePeriodicVestingAccount", nil)
+	cdc.RegisterConcrete(&DelegableDelayedVestingAccount{}, "cosmos-sdk/DelegableDelayedVestingAccount", nil)
+}
+
+// RegisterInterfaces registers the delegable vesting account implementations
+// of VestingAccount, AccountI and GenesisAccount.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*exported.VestingAccount)(nil),
+		&DelegableContinuousVestingAccount{},
+		&DelegablePeriodicVestingAccount{},
+		&DelegableDelayedVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*authtypes.AccountI)(nil),
+		&DelegableContinuousVestingAccount{},
+		&DelegablePeriodicVestingAccount{},
+		&DelegableDelayedVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*authtypes.GenesisAccount)(nil),
+		&DelegableContinuousVestingAccount{},
+		&DelegablePeriodicVestingAccount{},
+		&DelegableDelayedVestingAccount{},
+	)
+}
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// TestDelegableVestingAccountsHaveDistinctAnyTypeURLs packs each
+// Delegable*VestingAccount into an Any and checks that it round-trips under
+// its own registered type URL rather than the embedded base account's: since
+// the Delegable wrappers add no new fields, a missed registration here would
+// silently resolve through the embedded type and collide with it instead of
+// failing loudly.
+func TestDelegableVestingAccountsHaveDistinctAnyTypeURLs(t *testing.T) {
+	now := time.Now()
+	addr := sdk.AccAddress([]byte("delegableAnyRoundTrip"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+
+	cva := types.NewContinuousVestingAccount(baseAcc, origCoins, now.Unix(), now.Add(100*time.Second).Unix())
+	pva := types.NewPeriodicVestingAccount(baseAcc, origCoins, now.Unix(), types.Periods{
+		{Length: 100, Amount: origCoins},
+	})
+	dva := types.NewDelayedVestingAccount(baseAcc, origCoins, now.Add(100*time.Second).Unix())
+
+	cases := []struct {
+		name        string
+		account     authtypes.GenesisAccount
+		wantTypeURL string
+	}{
+		{"continuous", types.NewDelegableContinuousVestingAccount(cva), "/cosmos.vesting.v1beta1.DelegableContinuousVestingAccount"},
+		{"periodic", types.NewDelegablePeriodicVestingAccount(pva), "/cosmos.vesting.v1beta1.DelegablePeriodicVestingAccount"},
+		{"delayed", types.NewDelegableDelayedVestingAccount(dva), "/cosmos.vesting.v1beta1.DelegableDelayedVestingAccount"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			any, err := codectypes.NewAnyWithValue(tc.account)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantTypeURL, any.TypeUrl)
+
+			var unpacked authtypes.GenesisAccount
+			registry := codectypes.NewInterfaceRegistry()
+			types.RegisterInterfaces(registry)
+			require.NoError(t, registry.UnpackAny(any, &unpacked))
+			require.Equal(t, tc.account, unpacked)
+		})
+	}
+}
+
+// TestDelegableVestingAccountsDoNotPromoteEmbeddedMessageName confirms that
+// proto.MessageName resolves each Delegable*VestingAccount to its own
+// registered name, not to the embedded base account's: if the embedded type
+// promoted an XXX_MessageName method, it would shadow the outer type's
+// identity and RegisterInterfaces would panic on startup from the resulting
+// type-URL collision.
+func TestDelegableVestingAccountsDoNotPromoteEmbeddedMessageName(t *testing.T) {
+	now := time.Now()
+	addr := sdk.AccAddress([]byte("delegableMsgNameCheck"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+	cva := types.NewContinuousVestingAccount(baseAcc, origCoins, now.Unix(), now.Add(100*time.Second).Unix())
+	dcva := types.NewDelegableContinuousVestingAccount(cva)
+
+	require.Equal(t, "cosmos.vesting.v1beta1.DelegableContinuousVestingAccount", proto.MessageName(dcva))
+	require.NotEqual(t, proto.MessageName(cva), proto.MessageName(dcva))
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegableContinuousVestingAccount is a ContinuousVestingAccount variant
+// that permits delegating unvested tokens, as required by liquid staking
+// (LSM) ecosystems built on this chain. Unlike ContinuousVestingAccount,
+// LockedCoinsFromDelegating never reports vesting coins as locked from
+// delegation - TrackDelegation (inherited from BaseVestingAccount) still
+// records the usual DelegatedVesting/DelegatedFree split, so slashing and
+// undelegation reconcile exactly as they do for any other vesting account.
+//
+// It carries exactly the same fields as ContinuousVestingAccount, so its
+// wire encoding is identical and Marshal/Unmarshal simply delegate to the
+// embedded account; what makes it a distinct proto message - and gives it
+// its own Any type URL instead of colliding with ContinuousVestingAccount's
+// - is the proto.RegisterType call in codec.go naming it separately.
+type DelegableContinuousVestingAccount struct {
+	*ContinuousVestingAccount
+}
+
+// NewDelegableContinuousVestingAccount returns a new
+// DelegableContinuousVestingAccount wrapping cva.
+func NewDelegableContinuousVestingAccount(cva *ContinuousVestingAccount) *DelegableContinuousVestingAccount {
+	return &DelegableContinuousVestingAccount{ContinuousVestingAccount: cva}
+}
+
+// LockedCoinsFromDelegating allows delegating unvested tokens: it never
+// locks any coins from delegation, regardless of the vesting schedule.
+func (dcva DelegableContinuousVestingAccount) LockedCoinsFromDelegating(_ time.Time) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+// LockedForTokenization returns the portion of this account's delegated
+// tokens that are still unvested at blockTime, and so must not be tokenized
+// via x/staking's MsgTokenizeShares until they vest.
+func (dcva DelegableContinuousVestingAccount) LockedForTokenization(blockTime time.Time) sdk.Coins {
+	return minCoins(dcva.DelegatedVesting, dcva.GetVestingCoins(blockTime))
+}
+
+// Reset, String and ProtoMessage give DelegableContinuousVestingAccount its
+// own proto.Message identity distinct from the embedded
+// ContinuousVestingAccount's, which is what RegisterInterfaces needs to
+// resolve a non-colliding Any type URL for it.
+func (dcva *DelegableContinuousVestingAccount) Reset() { *dcva = DelegableContinuousVestingAccount{} }
+func (dcva *DelegableContinuousVestingAccount) String() string {
+	return dcva.ContinuousVestingAccount.String()
+}
+func (*DelegableContinuousVestingAccount) ProtoMessage() {}
+
+func (dcva *DelegableContinuousVestingAccount) Marshal() ([]byte, error) {
+	return dcva.ContinuousVestingAccount.Marshal()
+}
+func (dcva *DelegableContinuousVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	return dcva.ContinuousVestingAccount.MarshalTo(dAtA)
+}
+func (dcva *DelegableContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return dcva.ContinuousVestingAccount.MarshalToSizedBuffer(dAtA)
+}
+func (dcva *DelegableContinuousVestingAccount) Size() int {
+	return dcva.ContinuousVestingAccount.Size()
+}
+func (dcva *DelegableContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+	dcva.ContinuousVestingAccount = &ContinuousVestingAccount{}
+	return dcva.ContinuousVestingAccount.Unmarshal(dAtA)
+}
+
+// DelegablePeriodicVestingAccount is the PeriodicVestingAccount counterpart
+// of DelegableContinuousVestingAccount.
+type DelegablePeriodicVestingAccount struct {
+	*PeriodicVestingAccount
+}
+
+// NewDelegablePeriodicVestingAccount returns a new
+// DelegablePeriodicVestingAccount wrapping pva.
+func NewDelegablePeriodicVestingAccount(pva *PeriodicVestingAccount) *DelegablePeriodicVestingAccount {
+	return &DelegablePeriodicVestingAccount{PeriodicVestingAccount: pva}
+}
+
+func (dpva DelegablePeriodicVestingAccount) LockedCoinsFromDelegating(_ time.Time) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (dpva DelegablePeriodicVestingAccount) LockedForTokenization(blockTime time.Time) sdk.Coins {
+	return minCoins(dpva.DelegatedVesting, dpva.GetVestingCoins(blockTime))
+}
+
+func (dpva *DelegablePeriodicVestingAccount) Reset() { *dpva = DelegablePeriodicVestingAccount{} }
+func (dpva *DelegablePeriodicVestingAccount) String() string {
+	return dpva.PeriodicVestingAccount.String()
+}
+func (*DelegablePeriodicVestingAccount) ProtoMessage() {}
+
+func (dpva *DelegablePeriodicVestingAccount) Marshal() ([]byte, error) {
+	return dpva.PeriodicVestingAccount.Marshal()
+}
+func (dpva *DelegablePeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	return dpva.PeriodicVestingAccount.MarshalTo(dAtA)
+}
+func (dpva *DelegablePeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return dpva.PeriodicVestingAccount.MarshalToSizedBuffer(dAtA)
+}
+func (dpva *DelegablePeriodicVestingAccount) Size() int { return dpva.PeriodicVestingAccount.Size() }
+func (dpva *DelegablePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+	dpva.PeriodicVestingAccount = &PeriodicVestingAccount{}
+	return dpva.PeriodicVestingAccount.Unmarshal(dAtA)
+}
+
+// DelegableDelayedVestingAccount is the DelayedVestingAccount counterpart of
+// DelegableContinuousVestingAccount.
+type DelegableDelayedVestingAccount struct {
+	*DelayedVestingAccount
+}
+
+// NewDelegableDelayedVestingAccount returns a new
+// DelegableDelayedVestingAccount wrapping dva.
+func NewDelegableDelayedVestingAccount(dva *DelayedVestingAccount) *DelegableDelayedVestingAccount {
+	return &DelegableDelayedVestingAccount{DelayedVestingAccount: dva}
+}
+
+func (ddva DelegableDelayedVestingAccount) LockedCoinsFromDelegating(_ time.Time) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (ddva DelegableDelayedVestingAccount) LockedForTokenization(blockTime time.Time) sdk.Coins {
+	return minCoins(ddva.DelegatedVesting, ddva.GetVestingCoins(blockTime))
+}
+
+func (ddva *DelegableDelayedVestingAccount) Reset() { *ddva = DelegableDelayedVestingAccount{} }
+func (ddva *DelegableDelayedVestingAccount) String() string {
+	return ddva.DelayedVestingAccount.String()
+}
+func (*DelegableDelayedVestingAccount) ProtoMessage() {}
+
+func (ddva *DelegableDelayedVestingAccount) Marshal() ([]byte, error) {
+	return ddva.DelayedVestingAccount.Marshal()
+}
+func (ddva *DelegableDelayedVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	return ddva.DelayedVestingAccount.MarshalTo(dAtA)
+}
+func (ddva *DelegableDelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return ddva.DelayedVestingAccount.MarshalToSizedBuffer(dAtA)
+}
+func (ddva *DelegableDelayedVestingAccount) Size() int { return ddva.DelayedVestingAccount.Size() }
+func (ddva *DelegableDelayedVestingAccount) Unmarshal(dAtA []byte) error {
+	ddva.DelayedVestingAccount = &DelayedVestingAccount{}
+	return ddva.DelayedVestingAccount.Unmarshal(dAtA)
+}
+
+// minCoins returns, for every denom in a, the smaller of its amount in a and
+// its amount in b. Denoms present only in b are ignored.
+func minCoins(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range a {
+		amt := coin.Amount
+		if other := b.AmountOf(coin.Denom); other.LT(amt) {
+			amt = other
+		}
+		if amt.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return min
+}
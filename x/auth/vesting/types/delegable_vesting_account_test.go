@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestDelegableContinuousVestingAccountAllowsDelegatingUnvested(t *testing.T) {
+	now := time.Now()
+	addr := sdk.AccAddress([]byte("delegableVestingAcct"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+	cva := types.NewContinuousVestingAccount(baseAcc, origCoins, now.Unix(), now.Add(100*time.Second).Unix())
+	dcva := types.NewDelegableContinuousVestingAccount(cva)
+
+	// nothing has vested yet: an ordinary ContinuousVestingAccount would
+	// report the full balance as locked from delegation, but the delegable
+	// variant must allow it.
+	require.True(t, dcva.LockedCoinsFromDelegating(now).IsZero())
+
+	// delegate the entire unvested balance.
+	dcva.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, dcva.DelegatedVesting)
+	require.True(t, dcva.DelegatedFree.IsZero())
+
+	// at t=0 nothing has vested, so the whole delegation is still locked
+	// from tokenization.
+	require.Equal(t, origCoins, dcva.LockedForTokenization(now))
+
+	// halfway through the vesting schedule, half should have unlocked.
+	halfway := now.Add(50 * time.Second)
+	locked := dcva.LockedForTokenization(halfway)
+	require.Equal(t, sdk.NewInt(50), locked.AmountOf(sdk.DefaultBondDenom))
+
+	// once fully vested, nothing is locked from tokenization any more even
+	// though the coins are still recorded as DelegatedVesting.
+	require.True(t, dcva.LockedForTokenization(now.Add(200*time.Second)).IsZero())
+}
+
+func TestDelegableDelayedVestingAccountLockedForTokenization(t *testing.T) {
+	now := time.Now()
+	addr := sdk.AccAddress([]byte("delegableDelayedAcct"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+	dva := types.NewDelayedVestingAccount(baseAcc, origCoins, now.Add(100*time.Second).Unix())
+	ddva := types.NewDelegableDelayedVestingAccount(dva)
+
+	require.True(t, ddva.LockedCoinsFromDelegating(now).IsZero())
+
+	ddva.TrackDelegation(now, origCoins, origCoins)
+
+	// delayed vesting unlocks all-at-once: before the vesting end time, the
+	// full delegated amount is still locked from tokenization.
+	require.Equal(t, origCoins, ddva.LockedForTokenization(now))
+
+	// after the vesting end time, nothing is locked any more.
+	require.True(t, ddva.LockedForTokenization(now.Add(200*time.Second)).IsZero())
+}
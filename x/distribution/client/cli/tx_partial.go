@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// NewWithdrawRewardsPartialCmd returns a CLI command handler for creating a
+// MsgWithdrawDelegatorRewardPartial transaction.
+func NewWithdrawRewardsPartialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards-partial [validator-addr] [max-amounts]",
+		Short: "Withdraw up to max-amounts of rewards from a given delegation, carrying any remainder forward",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			maxAmounts, err := sdk.ParseCoinsNormalized(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawDelegatorRewardPartial(clientCtx.GetFromAddress(), valAddr, maxAmounts)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawTokenizeShareRecordRewardCmd returns a CLI command handler for
+// creating a MsgWithdrawTokenizeShareRecordReward transaction.
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-reward [record-id]",
+		Short: "Withdraw the rewards accrued by a tokenize share record to its current owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress(), recordID)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
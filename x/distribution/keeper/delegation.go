@@ -154,6 +154,12 @@ func (k Keeper) CalculateDelegationRewards(
 	return rewards
 }
 
+// withdrawDelegationRewards pays out this delegation's rewards, up to
+// maxAmounts per denom. A nil or empty maxAmounts means "no cap": claim
+// everything outstanding. Any amount that was computed but withheld by the
+// cap is persisted in the DelegationOutstandingRewards ledger and carried
+// forward to the next withdrawal rather than being lost or sent to the
+// community pool.
 func (k Keeper) withdrawDelegationRewards(
 	ctx sdk.Context,
 	validator stakingtypes.ValidatorI,
@@ -169,14 +175,16 @@ func (k Keeper) withdrawDelegationRewards(
 		return nil, types.ErrEmptyDelegationDistInfo
 	}
 
-	// end current period and calculate rewards
+	// end current period and calculate rewards accrued since the last
+	// withdrawal; this advances endingPeriod exactly once per call, whether
+	// or not the claim ends up being partial.
 	endingPeriod := k.IncrementValidatorPeriod(ctx, validator)
 	rewardsRaw := k.CalculateDelegationRewards(ctx, validator, delegation, endingPeriod)
-	outstanding := k.GetValidatorOutstandingRewardsCoins(ctx, validatorAddr)
+	validatorOutstanding := k.GetValidatorOutstandingRewardsCoins(ctx, validatorAddr)
 
 	// defensive edge case may happen on the very final digits
 	// of the decCoins due to operation order of the distribution mechanism.
-	rewards := rewardsRaw.Intersect(outstanding)
+	rewards := rewardsRaw.Intersect(validatorOutstanding)
 	if !rewards.IsEqual(rewardsRaw) {
 		logger := k.Logger(ctx)
 		logger.Info(
@@ -188,23 +196,30 @@ func (k Keeper) withdrawDelegationRewards(
 		)
 	}
 
-	// allocate the rewards to the DelegatorOutstandingRewards
-	outstanding := k.DelegationOutstandingRewards(ctx, delegatorAddr, validatorAddr)
-	outstanding = outstanding.Add(rewards...)
-
-	outstandingCpy := make(sdk.DecCoins, 0)
-	copy(outstandingCpy, outstanding)
-
-	// update the outstanding rewards by substracting max(maxAmt, outstanding)
-	var claimedRewards sdk.DecCoins
-	for _, decCoin := range outstanding {
-		maxAmt := sdk.MaxDec(decCoin.Amount, maxAmts.AmountOf(decCoin.Denom))
-		claimedRewards = claimedRewards.Add(sdk.NewDecCoinFromDec(decCoin.Denom, maxAmt))
+	// add the newly accrued rewards to whatever was withheld by a prior
+	// partial withdrawal of this same delegation.
+	delegationOutstanding := k.GetDelegationOutstandingRewards(ctx, delegatorAddr, validatorAddr)
+	delegationOutstanding = delegationOutstanding.Add(rewards...)
+
+	// claim min(delegationOutstanding, maxAmts) per denom; an empty maxAmts
+	// means claim everything.
+	claimedRewards := delegationOutstanding
+	if !maxAmts.IsZero() {
+		claimedRewards = sdk.DecCoins{}
+		for _, decCoin := range delegationOutstanding {
+			claimAmt := sdk.MinDec(decCoin.Amount, maxAmts.AmountOf(decCoin.Denom))
+			if claimAmt.IsPositive() {
+				claimedRewards = claimedRewards.Add(sdk.NewDecCoinFromDec(decCoin.Denom, claimAmt))
+			}
+		}
 	}
 
-	// maxDec := sdk.NewDecCoinsFromCoins(maxAmount...)
+	// whatever wasn't claimed carries forward to the next withdrawal.
+	carryOver := delegationOutstanding.Sub(claimedRewards)
 
-	// truncate reward dec coins, return remainder to community pool
+	// truncate reward dec coins, return remainder to community pool; this
+	// remainder is only ever sub-unit truncation dust from claimedRewards,
+	// never the carryOver withheld by the cap.
 	finalRewards, remainder := claimedRewards.TruncateDecimal()
 
 	// add coins to user account
@@ -217,10 +232,19 @@ func (k Keeper) withdrawDelegationRewards(
 	}
 
 	// update the outstanding rewards and the community pool only if the
-	// transaction was successful
-	k.SetValidatorOutstandingRewards(ctx, validatorAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
-	// TODO:
-	// k.SetDelegationOutstandingRewards(ctx, delegatorAddr, validatorAddr, outstanding)
+	// transaction was successful. Subtract claimedRewards - what actually
+	// left the module, to the delegator and/or the community pool - not the
+	// freshly-accrued rewards: any carryOver stays in the module account and
+	// is still owed, just earmarked in the per-delegation outstanding ledger
+	// rather than the general validator bucket, so it must stay counted here.
+	k.SetValidatorOutstandingRewards(ctx, validatorAddr, types.ValidatorOutstandingRewards{Rewards: validatorOutstanding.Sub(claimedRewards)})
+
+	if carryOver.IsZero() {
+		k.DeleteDelegationOutstandingRewards(ctx, delegatorAddr, validatorAddr)
+	} else {
+		k.SetDelegationOutstandingRewards(ctx, delegatorAddr, validatorAddr, carryOver)
+	}
+
 	feePool := k.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 	k.SetFeePool(ctx, feePool)
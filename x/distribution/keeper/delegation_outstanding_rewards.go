@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetDelegationOutstandingRewards returns the rewards that were computed for
+// this (delegator, validator) pair on a prior partial withdrawal but were
+// withheld because they exceeded that withdrawal's MaxAmounts, and so still
+// carry forward to the next withdrawal.
+func (k Keeper) GetDelegationOutstandingRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDelegationOutstandingRewardsKey(delAddr, valAddr))
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+
+	var rewards types.DelegatorOutstandingRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards.Rewards
+}
+
+// SetDelegationOutstandingRewards persists the outstanding rewards withheld
+// for this (delegator, validator) pair.
+func (k Keeper) SetDelegationOutstandingRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.DelegatorOutstandingRewards{Rewards: rewards})
+	store.Set(types.GetDelegationOutstandingRewardsKey(delAddr, valAddr), bz)
+}
+
+// DeleteDelegationOutstandingRewards removes any outstanding rewards withheld
+// for this (delegator, validator) pair, e.g. once they are fully claimed.
+func (k Keeper) DeleteDelegationOutstandingRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationOutstandingRewardsKey(delAddr, valAddr))
+}
+
+// IterateDelegationOutstandingRewardsForValidator iterates over every
+// delegator's outstanding rewards for a given validator.
+func (k Keeper) IterateDelegationOutstandingRewardsForValidator(
+	ctx sdk.Context,
+	valAddr sdk.ValAddress,
+	handler func(delAddr sdk.AccAddress, rewards sdk.DecCoins) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetDelegationOutstandingRewardsPrefixKey(valAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr := sdk.AccAddress(iterator.Key()[len(prefix):])
+
+		var rewards types.DelegatorOutstandingRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+
+		if handler(delAddr, rewards.Rewards) {
+			break
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupSingleDelegation bootstraps a bonded validator with a single
+// delegator and allocates one round of rewards, returning the addresses the
+// partial-withdrawal tests below act on.
+func setupSingleDelegation(t *testing.T) (*simapp.SimApp, sdk.Context, sdk.ValAddress, sdk.AccAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 1000))
+	valAddr := sdk.ValAddress(addrs[0])
+	delAddr := addrs[1]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, tstaking.ConsPubKey(), sdk.OneDec(), true)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, delAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 100),
+		stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// move to the next block: CalculateDelegationRewards computes nothing for
+	// a delegation in the block it started, so a withdrawal at the delegation's
+	// own height would see no rewards at all.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+
+	return app, ctx, valAddr, delAddr
+}
+
+// TestWithdrawDelegatorRewardPartialCarriesOverRemainder checks that a
+// partial claim pays out at most MaxAmounts, persists the shortfall in the
+// DelegationOutstandingRewards ledger without violating
+// DelegationOutstandingRewardsInvariant, and that a later full claim picks up
+// exactly that carried-over remainder.
+func TestWithdrawDelegatorRewardPartialCarriesOverRemainder(t *testing.T) {
+	app, ctx, valAddr, delAddr := setupSingleDelegation(t)
+	msgServer := distrkeeper.NewMsgServerImpl(app.DistrKeeper)
+
+	full := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+	half := full.AmountOf(sdk.DefaultBondDenom).TruncateInt().QuoRaw(2)
+	maxAmounts := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, half))
+
+	_, err := msgServer.WithdrawDelegatorRewardPartial(sdk.WrapSDKContext(ctx),
+		distrtypes.NewMsgWithdrawDelegatorRewardPartial(delAddr, valAddr, maxAmounts))
+	require.NoError(t, err)
+
+	outstanding := app.DistrKeeper.GetDelegationOutstandingRewards(ctx, delAddr, valAddr)
+	require.True(t, outstanding.IsAllPositive(), "shortfall should be carried over in the per-delegation ledger")
+
+	perDelegation := outstanding.AmountOf(sdk.DefaultBondDenom)
+	validatorOutstanding := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr).AmountOf(sdk.DefaultBondDenom)
+	require.True(t, perDelegation.LTE(validatorOutstanding),
+		"DelegationOutstandingRewardsInvariant: per-delegation ledger must never exceed the validator's bucket")
+
+	// a subsequent full claim should pick up exactly the carried-over amount
+	// and leave nothing behind.
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.True(t, app.DistrKeeper.GetDelegationOutstandingRewards(ctx, delAddr, valAddr).IsZero())
+}
+
+// TestDelegationOutstandingRewardsInvariantHolds exercises several partial
+// claims of varying sizes and asserts the ledger never exceeds the
+// validator's outstanding bucket at any point, matching
+// DelegationOutstandingRewardsInvariant.
+func TestDelegationOutstandingRewardsInvariantHolds(t *testing.T) {
+	app, ctx, valAddr, delAddr := setupSingleDelegation(t)
+	msgServer := distrkeeper.NewMsgServerImpl(app.DistrKeeper)
+
+	claims := []int64{10, 5, 1000}
+	for _, amt := range claims {
+		maxAmounts := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amt)))
+		_, err := msgServer.WithdrawDelegatorRewardPartial(sdk.WrapSDKContext(ctx),
+			distrtypes.NewMsgWithdrawDelegatorRewardPartial(delAddr, valAddr, maxAmounts))
+		require.NoError(t, err)
+
+		perDelegation := app.DistrKeeper.GetDelegationOutstandingRewards(ctx, delAddr, valAddr).AmountOf(sdk.DefaultBondDenom)
+		validatorOutstanding := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr).AmountOf(sdk.DefaultBondDenom)
+		require.True(t, perDelegation.LTE(validatorOutstanding))
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// DelegationOutstandingRewards implements the Query/DelegationOutstandingRewards
+// gRPC method, returning any rewards withheld from a prior partial withdrawal
+// of this delegation that have not yet been claimed.
+func (k Keeper) DelegationOutstandingRewards(c context.Context, req *types.QueryDelegationOutstandingRewardsRequest) (*types.QueryDelegationOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rewards := k.GetDelegationOutstandingRewards(ctx, delAddr, valAddr)
+
+	return &types.QueryDelegationOutstandingRewardsResponse{Rewards: rewards}, nil
+}
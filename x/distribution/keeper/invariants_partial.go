@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DelegationOutstandingRewardsInvariant checks that for every validator, the
+// sum of all per-delegation outstanding rewards never exceeds that
+// validator's total outstanding rewards - the per-delegation ledger is a
+// subset of (earmarked portion of) the validator-wide bucket, never more.
+func DelegationOutstandingRewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var broken bool
+		msg := ""
+
+		k.stakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+			valAddr := validator.GetOperator()
+			valOutstanding := k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+
+			delegationSum := sdk.DecCoins{}
+			k.IterateDelegationOutstandingRewardsForValidator(ctx, valAddr, func(_ sdk.AccAddress, rewards sdk.DecCoins) bool {
+				delegationSum = delegationSum.Add(rewards...)
+				return false
+			})
+
+			if delegationSum.IsAnyGT(valOutstanding) {
+				broken = true
+				msg += fmt.Sprintf(
+					"\tvalidator %v has delegation outstanding rewards %v which exceeds validator outstanding rewards %v\n",
+					valAddr, delegationSum, valOutstanding,
+				)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "delegation-outstanding-rewards",
+			fmt.Sprintf("delegation outstanding rewards invariant\n%s", msg),
+		), broken
+	}
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// WithdrawDelegatorRewardPartial withdraws up to msg.MaxAmounts of the
+// delegator's rewards from msg.ValidatorAddress, leaving any remainder on
+// the DelegationOutstandingRewards ledger for a later claim.
+func (k msgServer) WithdrawDelegatorRewardPartial(goCtx context.Context, msg *types.MsgWithdrawDelegatorRewardPartial) (*types.MsgWithdrawDelegatorRewardPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, types.ErrNoValidatorExists
+	}
+
+	del := k.stakingKeeper.Delegation(ctx, delegatorAddress, valAddr)
+	if del == nil {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	amount, err := k.withdrawDelegationRewards(ctx, val, del, msg.MaxAmounts)
+	if err != nil {
+		return nil, err
+	}
+
+	k.initializeDelegation(ctx, valAddr, delegatorAddress)
+
+	return &types.MsgWithdrawDelegatorRewardPartialResponse{Amount: amount}, nil
+}
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards accrued by a
+// TokenizeShareRecord's module account and pays them out to msg.OwnerAddress.
+func (k msgServer) WithdrawTokenizeShareRecordReward(goCtx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr, msg.TokenizeShareRecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
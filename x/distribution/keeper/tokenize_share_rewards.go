@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards accrued by a
+// TokenizeShareRecord's module account and pays them out to the record's
+// current owner. Rather than reimplementing reward withdrawal here, this
+// points the record account's withdraw address at the owner and defers
+// entirely to withdrawDelegationRewards/initializeDelegation - the same pair
+// every other delegation uses - so the period reference-count bookkeeping
+// (decrementReferenceCount + DeleteDelegatorStartingInfo on the old period,
+// incrementReferenceCount on the new one) stays correct. An earlier version
+// of this method open-coded the withdrawal and called initializeDelegation
+// without first releasing the prior starting period's reference, leaking one
+// historical-reward entry per call.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, found := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return nil, stakingtypes.ErrTokenizeShareRecordNotFound
+	}
+	if record.Owner != ownerAddr.String() {
+		return nil, types.ErrNoDelegationDistInfo
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+	recordAddr := stakingtypes.GetTokenizeShareRecordModuleAddress(recordID)
+
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	del := k.stakingKeeper.Delegation(ctx, recordAddr, valAddr)
+	if del == nil {
+		return nil, types.ErrEmptyDelegationDistInfo
+	}
+
+	k.SetDelegatorWithdrawAddr(ctx, recordAddr, ownerAddr)
+
+	withdrawn, err := k.withdrawDelegationRewards(ctx, val, del, nil)
+	if err != nil {
+		return nil, err
+	}
+	k.initializeDelegation(ctx, valAddr, recordAddr)
+
+	return withdrawn, nil
+}
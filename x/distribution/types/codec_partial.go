@@ -0,0 +1,237 @@
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math_bits "math/bits"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// This file hand-implements the proto.Message wire encoding that
+// protoc-gen-gogo would otherwise generate for the partial-withdrawal
+// message and query types added alongside msg_withdraw_partial.go and
+// grpc_query_partial.go: there is no .proto source for this extension, so
+// Marshal/Unmarshal are written directly against the gogoproto wire format
+// instead, following the same approach used for the LSM messages in
+// x/staking/types/codec_lsm.go.
+
+func init() {
+	proto.RegisterType((*MsgWithdrawDelegatorRewardPartial)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardPartial")
+	proto.RegisterType((*MsgWithdrawDelegatorRewardPartialResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardPartialResponse")
+	proto.RegisterType((*QueryDelegationOutstandingRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationOutstandingRewardsRequest")
+	proto.RegisterType((*QueryDelegationOutstandingRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationOutstandingRewardsResponse")
+}
+
+// RegisterPartialLegacyAminoCodec extends the distribution module's
+// LegacyAmino registration (RegisterLegacyAminoCodec in codec.go) with the
+// partial-withdrawal message types.
+func RegisterPartialLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgWithdrawDelegatorRewardPartial{}, "cosmos-sdk/MsgWithdrawDelegatorRewardPartial", nil)
+}
+
+// RegisterPartialInterfaces extends the distribution module's interface
+// registration (RegisterInterfaces in codec.go) with the partial-withdrawal
+// sdk.Msg implementations.
+func RegisterPartialInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawDelegatorRewardPartial{},
+	)
+}
+
+func sovPartial(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func encodeVarintPartial(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPartial(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+// decodeVarintPartial reads a single varint-encoded field (tag or length)
+// from dAtA starting at iNdEx, shared by every Unmarshal method below.
+func decodeVarintPartial(dAtA []byte, iNdEx int) (uint64, int, error) {
+	var v uint64
+	l := len(dAtA)
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 64 {
+			return 0, 0, ErrIntOverflowPartial
+		}
+		if iNdEx >= l {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		b := dAtA[iNdEx]
+		iNdEx++
+		v |= uint64(b&0x7F) << shift
+		if b < 0x80 {
+			break
+		}
+	}
+	return v, iNdEx, nil
+}
+
+// decodeStringPartial reads a length-delimited string field starting at iNdEx.
+func decodeStringPartial(dAtA []byte, iNdEx int) (string, int, error) {
+	b, next, err := decodeBytesPartial(dAtA, iNdEx)
+	if err != nil {
+		return "", 0, err
+	}
+	return string(b), next, nil
+}
+
+// decodeBytesPartial reads a length-delimited byte field starting at iNdEx.
+func decodeBytesPartial(dAtA []byte, iNdEx int) ([]byte, int, error) {
+	length, next, err := decodeVarintPartial(dAtA, iNdEx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if int(length) < 0 {
+		return nil, 0, ErrInvalidLengthPartial
+	}
+	postIndex := next + int(length)
+	if postIndex < 0 {
+		return nil, 0, ErrInvalidLengthPartial
+	}
+	if postIndex > len(dAtA) {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	return dAtA[next:postIndex], postIndex, nil
+}
+
+// errInvalidWireTypePartial reports a field decoded with a wire type it
+// cannot use.
+func errInvalidWireTypePartial(field string, wireType int) error {
+	return fmt.Errorf("proto: wrong wireType = %d for field %s", wireType, field)
+}
+
+// skipUnknownPartial skips the value of one unrecognized field whose tag
+// (and therefore wireType) has already been consumed by the caller,
+// advancing iNdEx past it and returning the new index, bounded by l.
+func skipUnknownPartial(dAtA []byte, iNdEx, l, wireType int) (int, error) {
+	switch wireType {
+	case 0:
+		_, next, err := decodeVarintPartial(dAtA, iNdEx)
+		if err != nil {
+			return 0, err
+		}
+		iNdEx = next
+	case 1:
+		iNdEx += 8
+	case 2:
+		length, next, err := decodeVarintPartial(dAtA, iNdEx)
+		if err != nil {
+			return 0, err
+		}
+		if int(length) < 0 {
+			return 0, ErrInvalidLengthPartial
+		}
+		iNdEx = next + int(length)
+	case 5:
+		iNdEx += 4
+	default:
+		return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+	}
+	if iNdEx < 0 {
+		return 0, ErrInvalidLengthPartial
+	}
+	if iNdEx > l {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return iNdEx, nil
+}
+
+// skipPartial advances past a single field (of any wire type) at the start
+// of dAtA and returns the number of bytes it spans. No Unmarshal method in
+// this package calls it; they skip unknown fields with skipUnknownPartial.
+func skipPartial(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPartial
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for {
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPartial
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPartial
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPartial
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPartial
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPartial        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPartial          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPartial = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// This file registers the proto.Message wire encoding for
+// MsgWithdrawTokenizeShareRecordReward, hand-implemented in
+// msg_withdraw_tokenize_share_reward_marshal.go against the gogoproto wire
+// format shared with codec_partial.go; there is no .proto source for this
+// extension.
+
+func init() {
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
+}
+
+// RegisterTokenizeShareRewardLegacyAminoCodec extends the distribution
+// module's LegacyAmino registration (RegisterLegacyAminoCodec in codec.go)
+// with MsgWithdrawTokenizeShareRecordReward.
+func RegisterTokenizeShareRewardLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeShareRecordReward", nil)
+}
+
+// RegisterTokenizeShareRewardInterfaces extends the distribution module's
+// interface registration (RegisterInterfaces in codec.go) with the
+// MsgWithdrawTokenizeShareRecordReward sdk.Msg implementation.
+func RegisterTokenizeShareRewardInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawTokenizeShareRecordReward{},
+	)
+}
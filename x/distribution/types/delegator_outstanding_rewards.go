@@ -0,0 +1,12 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// DelegatorOutstandingRewards represents the rewards accrued for a single
+// delegation that a partial MsgWithdrawDelegatorRewardPartial withheld
+// because they exceeded the requested MaxAmounts. It mirrors
+// ValidatorOutstandingRewards but is scoped per-delegation rather than
+// per-validator.
+type DelegatorOutstandingRewards struct {
+	Rewards sdk.DecCoins `json:"rewards" yaml:"rewards"`
+}
@@ -0,0 +1,21 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// DelegationOutstandingRewardsPrefix is the key prefix for per-delegation
+// outstanding rewards that were computed during a withdrawal but not paid
+// out because they exceeded the caller's requested MaxAmounts, and so carry
+// forward to the next withdrawal.
+var DelegationOutstandingRewardsPrefix = []byte{0x30}
+
+// GetDelegationOutstandingRewardsKey returns the key for a delegator's
+// outstanding rewards for a given validator.
+func GetDelegationOutstandingRewardsKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationOutstandingRewardsPrefixKey(valAddr), delAddr.Bytes()...)
+}
+
+// GetDelegationOutstandingRewardsPrefixKey returns the prefix used to
+// iterate over every delegator's outstanding rewards for a given validator.
+func GetDelegationOutstandingRewardsPrefixKey(valAddr sdk.ValAddress) []byte {
+	return append(append([]byte{}, DelegationOutstandingRewardsPrefix...), valAddr.Bytes()...)
+}
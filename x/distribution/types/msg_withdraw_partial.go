@@ -0,0 +1,75 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWithdrawDelegatorRewardPartial = "withdraw_delegator_reward_partial"
+
+// MsgWithdrawDelegatorRewardPartial withdraws up to MaxAmounts of the
+// delegator's outstanding rewards from a single validator, per denom. Any
+// amount that was earned but exceeds MaxAmounts is left on the
+// DelegationOutstandingRewards ledger and claimable on a later withdrawal,
+// rather than being forfeited.
+type MsgWithdrawDelegatorRewardPartial struct {
+	DelegatorAddress string    `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress string    `json:"validator_address" yaml:"validator_address"`
+	MaxAmounts       sdk.Coins `json:"max_amounts" yaml:"max_amounts"`
+}
+
+func NewMsgWithdrawDelegatorRewardPartial(delAddr sdk.AccAddress, valAddr sdk.ValAddress, maxAmounts sdk.Coins) *MsgWithdrawDelegatorRewardPartial {
+	return &MsgWithdrawDelegatorRewardPartial{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		MaxAmounts:       maxAmounts,
+	}
+}
+
+func (msg MsgWithdrawDelegatorRewardPartial) Route() string { return ModuleName }
+func (msg MsgWithdrawDelegatorRewardPartial) Type() string {
+	return TypeMsgWithdrawDelegatorRewardPartial
+}
+
+func (msg MsgWithdrawDelegatorRewardPartial) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+func (msg MsgWithdrawDelegatorRewardPartial) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid delegator address")
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid validator address")
+	}
+	if err := msg.MaxAmounts.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid max amounts")
+	}
+	if msg.MaxAmounts.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max amounts must not be empty, use MsgWithdrawDelegatorReward for a full claim")
+	}
+	return nil
+}
+
+func (m *MsgWithdrawDelegatorRewardPartial) Reset()         { *m = MsgWithdrawDelegatorRewardPartial{} }
+func (m *MsgWithdrawDelegatorRewardPartial) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgWithdrawDelegatorRewardPartial) ProtoMessage()    {}
+
+// MsgWithdrawDelegatorRewardPartialResponse returns the amount actually
+// withdrawn, which may be less than MaxAmounts if the delegation had not
+// earned that much yet.
+type MsgWithdrawDelegatorRewardPartialResponse struct {
+	Amount sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+func (m *MsgWithdrawDelegatorRewardPartialResponse) Reset() {
+	*m = MsgWithdrawDelegatorRewardPartialResponse{}
+}
+func (m *MsgWithdrawDelegatorRewardPartialResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgWithdrawDelegatorRewardPartialResponse) ProtoMessage()    {}
@@ -0,0 +1,405 @@
+package types
+
+import (
+	io "io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgWithdrawDelegatorRewardPartial
+
+func (m *MsgWithdrawDelegatorRewardPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDelegatorRewardPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDelegatorRewardPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if len(m.MaxAmounts) > 0 {
+		for iNdEx := len(m.MaxAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.MaxAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPartial(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintPartial(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintPartial(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDelegatorRewardPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	l := len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPartial(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPartial(uint64(l))
+	}
+	for _, e := range m.MaxAmounts {
+		l = e.Size()
+		n += 1 + l + sovPartial(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDelegatorRewardPartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintPartial(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireTypePartial("DelegatorAddress", wireType)
+			}
+			s, next, err := decodeStringPartial(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.DelegatorAddress, iNdEx = s, next
+		case 2:
+			if wireType != 2 {
+				return errInvalidWireTypePartial("ValidatorAddress", wireType)
+			}
+			s, next, err := decodeStringPartial(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ValidatorAddress, iNdEx = s, next
+		case 3:
+			if wireType != 2 {
+				return errInvalidWireTypePartial("MaxAmounts", wireType)
+			}
+			b, next, err := decodeBytesPartial(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.MaxAmounts = append(m.MaxAmounts, sdk.Coin{})
+			if err := m.MaxAmounts[len(m.MaxAmounts)-1].Unmarshal(b); err != nil {
+				return err
+			}
+			iNdEx = next
+		default:
+			iNdEx, err = skipUnknownPartial(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// coinsMarshalToSizedBuffer and coinsSize/coinsUnmarshal below are shared by
+// every message here that carries a single repeated sdk.Coin field at field
+// number 1 (MsgWithdrawDelegatorRewardPartialResponse.Amount and
+// MsgWithdrawTokenizeShareRecordRewardResponse.Amount).
+
+func coinsMarshalToSizedBuffer(dAtA []byte, coins sdk.Coins) (int, error) {
+	i := len(dAtA)
+	for iNdEx := len(coins) - 1; iNdEx >= 0; iNdEx-- {
+		size, err := coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPartial(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func coinsSize(coins sdk.Coins) (n int) {
+	for _, e := range coins {
+		l := e.Size()
+		n += 1 + l + sovPartial(uint64(l))
+	}
+	return n
+}
+
+func coinsUnmarshal(dAtA []byte, coins *sdk.Coins, fieldNum int32, wireType int, iNdEx int) (int, error) {
+	if fieldNum != 1 || wireType != 2 {
+		return 0, errInvalidWireTypePartial("Amount", wireType)
+	}
+	b, next, err := decodeBytesPartial(dAtA, iNdEx)
+	if err != nil {
+		return 0, err
+	}
+	*coins = append(*coins, sdk.Coin{})
+	if err := (*coins)[len(*coins)-1].Unmarshal(b); err != nil {
+		return 0, err
+	}
+	return next, nil
+}
+
+// MsgWithdrawDelegatorRewardPartialResponse
+
+func (m *MsgWithdrawDelegatorRewardPartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDelegatorRewardPartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDelegatorRewardPartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return coinsMarshalToSizedBuffer(dAtA, m.Amount)
+}
+
+func (m *MsgWithdrawDelegatorRewardPartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	return coinsSize(m.Amount)
+}
+
+func (m *MsgWithdrawDelegatorRewardPartialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintPartial(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if fieldNum == 1 {
+			iNdEx, err = coinsUnmarshal(dAtA, &m.Amount, fieldNum, wireType, iNdEx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		iNdEx, err = skipUnknownPartial(dAtA, iNdEx, l, wireType)
+		if err != nil {
+			return err
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// QueryDelegationOutstandingRewardsRequest
+
+func (m *QueryDelegationOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintPartial(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintPartial(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	l := len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPartial(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPartial(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintPartial(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireTypePartial("DelegatorAddress", wireType)
+			}
+			s, next, err := decodeStringPartial(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.DelegatorAddress, iNdEx = s, next
+		case 2:
+			if wireType != 2 {
+				return errInvalidWireTypePartial("ValidatorAddress", wireType)
+			}
+			s, next, err := decodeStringPartial(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ValidatorAddress, iNdEx = s, next
+		default:
+			iNdEx, err = skipUnknownPartial(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// QueryDelegationOutstandingRewardsResponse
+
+func (m *QueryDelegationOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+		size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPartial(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	for _, e := range m.Rewards {
+		l := e.Size()
+		n += 1 + l + sovPartial(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintPartial(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireTypePartial("Rewards", wireType)
+			}
+			b, next, err := decodeBytesPartial(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Rewards = append(m.Rewards, sdk.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(b); err != nil {
+				return err
+			}
+			iNdEx = next
+		default:
+			iNdEx, err = skipUnknownPartial(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
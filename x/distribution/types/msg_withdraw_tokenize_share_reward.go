@@ -0,0 +1,65 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards accrued by a
+// TokenizeShareRecord's module account and pays them out to the record's
+// current owner, via Keeper.WithdrawTokenizeShareRecordReward.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress          string `json:"owner_address" yaml:"owner_address"`
+	TokenizeShareRecordId uint64 `json:"tokenize_share_record_id" yaml:"tokenize_share_record_id"`
+}
+
+func NewMsgWithdrawTokenizeShareRecordReward(owner sdk.AccAddress, recordID uint64) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress:          owner.String(),
+		TokenizeShareRecordId: recordID,
+	}
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+	return nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset() { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string {
+	return fmt.Sprintf("%+v", *m)
+}
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage() {}
+
+// MsgWithdrawTokenizeShareRecordRewardResponse returns the amount withdrawn
+// to the owner.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return fmt.Sprintf("%+v", *m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	io "io"
+)
+
+// MsgWithdrawTokenizeShareRecordReward
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TokenizeShareRecordId != 0 {
+		i = encodeVarintPartial(dAtA, i, uint64(m.TokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintPartial(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	l := len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovPartial(uint64(l))
+	}
+	if m.TokenizeShareRecordId != 0 {
+		n += 1 + sovPartial(uint64(m.TokenizeShareRecordId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintPartial(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireTypePartial("OwnerAddress", wireType)
+			}
+			s, next, err := decodeStringPartial(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OwnerAddress, iNdEx = s, next
+		case 2:
+			if wireType != 0 {
+				return errInvalidWireTypePartial("TokenizeShareRecordId", wireType)
+			}
+			v, next, err := decodeVarintPartial(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.TokenizeShareRecordId, iNdEx = v, next
+		default:
+			iNdEx, err = skipUnknownPartial(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// MsgWithdrawTokenizeShareRecordRewardResponse
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return coinsMarshalToSizedBuffer(dAtA, m.Amount)
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	return coinsSize(m.Amount)
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintPartial(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if fieldNum == 1 {
+			iNdEx, err = coinsUnmarshal(dAtA, &m.Amount, fieldNum, wireType, iNdEx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		iNdEx, err = skipUnknownPartial(dAtA, iNdEx, l, wireType)
+		if err != nil {
+			return err
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryDelegationOutstandingRewardsRequest is the request type for the
+// Query/DelegationOutstandingRewards gRPC method.
+type QueryDelegationOutstandingRewardsRequest struct {
+	DelegatorAddress string `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress string `json:"validator_address" yaml:"validator_address"`
+}
+
+func (m *QueryDelegationOutstandingRewardsRequest) Reset() {
+	*m = QueryDelegationOutstandingRewardsRequest{}
+}
+func (m *QueryDelegationOutstandingRewardsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*QueryDelegationOutstandingRewardsRequest) ProtoMessage()    {}
+
+// QueryDelegationOutstandingRewardsResponse is the response type for the
+// Query/DelegationOutstandingRewards gRPC method.
+type QueryDelegationOutstandingRewardsResponse struct {
+	Rewards sdk.DecCoins `json:"rewards" yaml:"rewards"`
+}
+
+func (m *QueryDelegationOutstandingRewardsResponse) Reset() {
+	*m = QueryDelegationOutstandingRewardsResponse{}
+}
+func (m *QueryDelegationOutstandingRewardsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*QueryDelegationOutstandingRewardsResponse) ProtoMessage()    {}
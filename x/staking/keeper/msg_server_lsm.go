@@ -0,0 +1,378 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TokenizeShares converts a portion of msg.DelegatorAddress's delegation to
+// msg.ValidatorAddress into a transferable share token, owned by
+// msg.TokenizedShareOwner. The underlying delegation is moved to a new
+// per-record module account so that rewards keep accruing and slashing keeps
+// applying to it on behalf of the share token holders.
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+		return nil, err
+	}
+
+	if k.IsTokenizeSharesLocked(ctx, delegatorAddress) {
+		return nil, types.ErrTokenizeSharesDisabled
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegatorForAddress
+	}
+	if delegation.ValidatorBond {
+		return nil, sdkerrors.Wrap(types.ErrTokenizeSharesDisabled, "validator-bonded delegations may not be tokenized")
+	}
+
+	if err := k.checkTokenizeShareLock(ctx, delegatorAddress, validator, delegation, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkTokenizeShareRecordCaps(ctx, validator, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.NewTokenizeShareRecord(recordID, msg.TokenizedShareOwner, msg.ValidatorAddress)
+	moduleAcc := types.GetTokenizeShareRecordModuleAddress(recordID)
+
+	// move the tokenized portion of the delegation from the delegator to the
+	// record's module account, then mint the transferable share token.
+	returnAmount, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	// Unbond only removed the shares; returnAmount is still in the validator's
+	// pool. Re-delegate it from the record's module account with
+	// tokenSrc = validator.Status and subtractAccount = false so that no coins
+	// move between pools, leaving the validator's total voting power unchanged.
+	newShares, err := k.Delegate(ctx, moduleAcc, returnAmount, validator.Status, validator, false)
+	if err != nil {
+		return nil, err
+	}
+
+	shareToken := sdk.NewCoin(types.GetShareTokenDenom(msg.ValidatorAddress, recordID), msg.Amount.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.TokenizedShareOwner)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddr, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	k.SetTokenizeShareRecord(ctx, record)
+	validator.TotalLiquidShares = validator.TotalLiquidShares.Add(newShares)
+	k.SetValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShareTokenDenom, shareToken.Denom),
+		),
+	)
+
+	return &types.MsgTokenizeSharesResponse{Amount: shareToken}, nil
+}
+
+// RedeemTokensForShares burns a share token and restores a regular delegation
+// to the sender, shrinking (or removing, once fully redeemed) the backing
+// TokenizeShareRecord and its module account's delegation by the same amount.
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	recordID, valAddrStr, err := types.ParseShareTokenDenom(msg.Amount.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidShareTokenDenom, err.Error())
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotFound
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleAcc := types.GetTokenizeShareRecordModuleAddress(recordID)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	recordDelegation, found := k.GetDelegation(ctx, moduleAcc, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegatorForAddress
+	}
+
+	// the outstanding share token supply must be read before it is burned
+	// below, and compared against the record's *shares* (not a token face
+	// value): a validator slashing event since tokenization can leave the
+	// record's module account holding fewer tokens than the share token
+	// supply implies. Redeeming shares = recordDelegation.Shares *
+	// msg.Amount/supply applies that loss pro-rata across every holder,
+	// instead of the face-value redemption this replaces, which failed
+	// ValidateUnbondAmount's insufficient-funds check for everyone once the
+	// validator had been slashed.
+	shareTokenSupply := k.bankKeeper.GetSupply(ctx, msg.Amount.Denom).Amount
+	if !shareTokenSupply.IsPositive() {
+		return nil, types.ErrInvalidShareTokenDenom
+	}
+	shares := recordDelegation.Shares.MulInt(msg.Amount.Amount).QuoInt(shareTokenSupply)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, moduleAcc, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Delegate(ctx, delegatorAddress, returnAmount, validator.Status, validator, false); err != nil {
+		return nil, err
+	}
+
+	validator, _ = k.GetValidator(ctx, valAddr)
+	validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(shares)
+	k.SetValidator(ctx, validator)
+
+	remaining := k.bankKeeper.GetSupply(ctx, msg.Amount.Denom)
+	if remaining.Amount.IsZero() {
+		k.DeleteTokenizeShareRecord(ctx, recordID)
+	}
+
+	return &types.MsgRedeemTokensForSharesResponse{Amount: sdk.NewCoin(k.BondDenom(ctx), returnAmount)}, nil
+}
+
+// TransferTokenizeShareRecord reassigns ownership of a TokenizeShareRecord.
+func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, found := k.GetTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotFound
+	}
+	if record.Owner != msg.Sender {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sender does not own this tokenize share record")
+	}
+
+	k.DeleteTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	record.Owner = msg.NewOwner
+	k.SetTokenizeShareRecord(ctx, record)
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// DisableTokenizeShares locks the sender's delegations from being tokenized.
+func (k msgServer) DisableTokenizeShares(goCtx context.Context, msg *types.MsgDisableTokenizeShares) (*types.MsgDisableTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	k.SetTokenizeSharesLocked(ctx, delegator, true)
+	return &types.MsgDisableTokenizeSharesResponse{}, nil
+}
+
+// EnableTokenizeShares reverses a prior DisableTokenizeShares.
+func (k msgServer) EnableTokenizeShares(goCtx context.Context, msg *types.MsgEnableTokenizeShares) (*types.MsgEnableTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	k.SetTokenizeSharesLocked(ctx, delegator, false)
+	return &types.MsgEnableTokenizeSharesResponse{}, nil
+}
+
+// ValidatorBond flags a delegation as the validator's own self-bond, which
+// counts towards that validator's ValidatorBondFactor liquid staking
+// headroom and can never itself be tokenized.
+func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegatorForAddress
+	}
+	if delegation.ValidatorBond {
+		return nil, types.ErrValidatorBondAlreadySet
+	}
+
+	delegation.ValidatorBond = true
+	k.SetDelegation(ctx, delegation)
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+	validator.ValidatorBondShares = validator.ValidatorBondShares.Add(delegation.Shares)
+	k.SetValidator(ctx, validator)
+
+	return &types.MsgValidatorBondResponse{}, nil
+}
+
+// checkTokenizeShareLock rejects a tokenize request that would dip into the
+// portion of the delegator's stake that a DelegableVestingAccount reports as
+// types.LockedForTokenization - i.e. coins delegated from an unvested
+// balance, which must vest before they can back a transferable share token.
+//
+// LockedForTokenization is tracked account-wide, not per validator (it is
+// derived from BaseVestingAccount's DelegatedVesting, which has no
+// per-validator breakdown), so a delegator with delegations split across
+// several validators has the lock apportioned across them in proportion to
+// each delegation's size. This keeps the sum of what every delegation is
+// allowed to tokenize equal to the true account-wide vested amount, rather
+// than applying the full lock independently to each validator.
+func (k Keeper) checkTokenizeShareLock(ctx sdk.Context, delegator sdk.AccAddress, validator types.Validator, delegation types.Delegation, tokenizeAmt sdk.Int) error {
+	account := k.authKeeper.GetAccount(ctx, delegator)
+	locker, ok := account.(types.TokenizeShareLocker)
+	if !ok {
+		return nil
+	}
+
+	lockedForTokenization := locker.LockedForTokenization(ctx.BlockTime()).AmountOf(k.BondDenom(ctx))
+	if !lockedForTokenization.IsPositive() {
+		return nil
+	}
+
+	delegationValue := validator.TokensFromSharesTruncated(delegation.Shares).TruncateInt()
+
+	totalDelegated := sdk.ZeroInt()
+	for _, d := range k.GetAllDelegatorDelegations(ctx, delegator) {
+		v, found := k.GetValidator(ctx, d.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		totalDelegated = totalDelegated.Add(v.TokensFromSharesTruncated(d.Shares).TruncateInt())
+	}
+	if !totalDelegated.IsPositive() {
+		return nil
+	}
+
+	lockedHere := lockedForTokenization.Mul(delegationValue).Quo(totalDelegated)
+	tokenizable := delegationValue.Sub(lockedHere)
+	if tokenizable.IsNegative() {
+		tokenizable = sdk.ZeroInt()
+	}
+
+	if tokenizeAmt.GT(tokenizable) {
+		return sdkerrors.Wrapf(types.ErrTokenizeSharesDisabled,
+			"%s of this delegation is still unvested and locked from tokenization", lockedHere)
+	}
+
+	return nil
+}
+
+// checkTokenizeShareRecordCaps enforces the three LSM caps: a tokenize
+// request must not push a validator's liquid shares past
+// ValidatorBondFactor * validator-bond shares, nor past
+// ValidatorLiquidStakingCap of that validator's total shares, nor past
+// GlobalLiquidStakingCap of the network's total bonded tokens.
+//
+// tokenizeAmt is a token amount; TotalLiquidShares, ValidatorBondShares and
+// DelegatorShares are share quantities, which diverge from tokens once a
+// validator has been slashed. It is converted to shares up front so the two
+// per-validator comparisons stay in share units; the global cap is compared
+// in tokens, against TotalLiquidStakedTokens and TotalBondedTokens.
+func (k Keeper) checkTokenizeShareRecordCaps(ctx sdk.Context, validator types.Validator, tokenizeAmt sdk.Int) error {
+	tokenizeShares, err := validator.SharesFromTokensTruncated(tokenizeAmt)
+	if err != nil {
+		return err
+	}
+
+	bondFactor := k.ValidatorBondFactor(ctx)
+	if !bondFactor.Equal(types.DefaultValidatorBondFactor) {
+		maxValLiquidShares := bondFactor.MulInt(validator.ValidatorBondShares.RoundInt())
+		newLiquidShares := validator.TotalLiquidShares.Add(tokenizeShares)
+		if newLiquidShares.GT(maxValLiquidShares) {
+			return types.ErrInsufficientValidatorBondShares
+		}
+	}
+
+	valCap := k.ValidatorLiquidStakingCap(ctx)
+	if valCap.LT(sdk.OneDec()) {
+		newLiquidShares := validator.TotalLiquidShares.Add(tokenizeShares)
+		if newLiquidShares.Quo(validator.DelegatorShares).GT(valCap) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	globalCap := k.GlobalLiquidStakingCap(ctx)
+	if globalCap.LT(sdk.OneDec()) {
+		totalLiquidStaked := k.TotalLiquidStakedTokens(ctx).Add(tokenizeAmt)
+		totalBonded := k.TotalBondedTokens(ctx)
+		if totalBonded.IsPositive() && sdk.NewDecFromInt(totalLiquidStaked).QuoInt(totalBonded).GT(globalCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// TotalLiquidStakedTokens sums TotalLiquidShares converted to tokens across
+// every validator; used to enforce GlobalLiquidStakingCap.
+func (k Keeper) TotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroInt()
+	k.IterateValidators(ctx, func(_ int64, validator types.ValidatorI) bool {
+		v := validator.(types.Validator)
+		total = total.Add(v.TokensFromSharesTruncated(v.TotalLiquidShares).TruncateInt())
+		return false
+	})
+	return total
+}
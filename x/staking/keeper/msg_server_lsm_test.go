@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupLSMFixture bootstraps a bonded validator with a single delegation of
+// 100 staking-power tokens, returning the addresses the LSM tests below act
+// on.
+func setupLSMFixture(t *testing.T) (*simapp.SimApp, sdk.Context, sdk.ValAddress, sdk.AccAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 1000))
+	valAddr := sdk.ValAddress(addrs[0])
+	delAddr := addrs[1]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, tstaking.ConsPubKey(), sdk.OneDec(), true)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, delAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 100),
+		types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	return app, ctx, valAddr, delAddr
+}
+
+// TestTokenizeAndRedeemRoundTrip checks that tokenizing part of a delegation
+// mints the expected share token, that the validator's TotalLiquidShares
+// reflects it, and that redeeming the share token back restores an ordinary
+// delegation and clears TotalLiquidShares and the TokenizeShareRecord.
+func TestTokenizeAndRedeemRoundTrip(t *testing.T) {
+	app, ctx, valAddr, delAddr := setupLSMFixture(t)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	tokenizeAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 40)
+	tokenizeResp, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx),
+		types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokenizeAmt), delAddr))
+	require.NoError(t, err)
+	require.Equal(t, tokenizeAmt, tokenizeResp.Amount.Amount)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.TotalLiquidShares.IsPositive())
+
+	redeemResp, err := msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx),
+		types.NewMsgRedeemTokensForShares(delAddr, tokenizeResp.Amount))
+	require.NoError(t, err)
+	require.True(t, redeemResp.Amount.Amount.IsPositive())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.TotalLiquidShares.IsZero(), "redeeming the full record should zero out TotalLiquidShares")
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found, "fully redeemed record should be deleted")
+}
+
+// TestTokenizeSharesRejectsValidatorBond checks that a delegation flagged via
+// MsgValidatorBond can never be tokenized.
+func TestTokenizeSharesRejectsValidatorBond(t *testing.T) {
+	app, ctx, valAddr, delAddr := setupLSMFixture(t)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx),
+		types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), delAddr))
+	require.ErrorIs(t, err, types.ErrTokenizeSharesDisabled)
+}
+
+// TestTokenizeSharesRejectsValidatorLiquidStakingCap checks that tokenizing
+// is rejected once it would push a validator's liquid shares past
+// ValidatorLiquidStakingCap of its total delegator shares.
+func TestTokenizeSharesRejectsValidatorLiquidStakingCap(t *testing.T) {
+	app, ctx, valAddr, delAddr := setupLSMFixture(t)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 1) // 10%
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx),
+		types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 50)), delAddr))
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ValidatorBondFactor returns the maximum multiple of a validator's
+// validator-bond shares that may back liquid staked (tokenized) shares for
+// that validator. A value of -1 disables the check.
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) sdk.Dec {
+	var res sdk.Dec
+	k.paramSpace.Get(ctx, types.KeyValidatorBondFactor, &res)
+	return res
+}
+
+// GlobalLiquidStakingCap returns the fraction of total bonded tokens,
+// network-wide, that may be liquid staked.
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	var res sdk.Dec
+	k.paramSpace.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return res
+}
+
+// ValidatorLiquidStakingCap returns the fraction of a single validator's
+// total delegation that may be liquid staked.
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	var res sdk.Dec
+	k.paramSpace.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return res
+}
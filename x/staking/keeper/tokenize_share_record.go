@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the last used TokenizeShareRecord id.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the last used TokenizeShareRecord id.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the TokenizeShareRecord with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (types.TokenizeShareRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, false
+	}
+
+	var record types.TokenizeShareRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetTokenizeShareRecord persists a TokenizeShareRecord and indexes it by owner.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), bz)
+	store.Set(types.GetTokenizeShareRecordsByOwnerKey([]byte(record.Owner), record.Id), []byte{})
+}
+
+// DeleteTokenizeShareRecord removes a TokenizeShareRecord and its owner index entry.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(id))
+	store.Delete(types.GetTokenizeShareRecordsByOwnerKey([]byte(record.Owner), id))
+}
+
+// GetTokenizeShareRecordsByOwner returns every TokenizeShareRecord owned by owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) []types.TokenizeShareRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordsByOwnerPrefixKey(owner)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		record, found := k.GetTokenizeShareRecord(ctx, id)
+		if found {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// IsTokenizeSharesLocked reports whether delegator has disabled tokenization
+// of its delegations via MsgDisableTokenizeShares.
+func (k Keeper) IsTokenizeSharesLocked(ctx sdk.Context, delegator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTokenizeShareLockedKey(delegator))
+}
+
+// SetTokenizeSharesLocked locks or unlocks tokenization of delegator's delegations.
+func (k Keeper) SetTokenizeSharesLocked(ctx sdk.Context, delegator sdk.AccAddress, locked bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTokenizeShareLockedKey(delegator)
+	if locked {
+		store.Set(key, []byte{1})
+	} else {
+		store.Delete(key)
+	}
+}
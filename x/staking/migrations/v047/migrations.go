@@ -0,0 +1,48 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore backfills the liquid staking module (LSM) fields introduced
+// onto Validator and Delegation: every existing validator gets
+// TotalLiquidShares = 0 and ValidatorBondShares = 0, and every existing
+// delegation gets ValidatorBond = false, since none of them were created
+// through the new MsgTokenizeShares/MsgValidatorBond flows.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	migrateValidators(store, cdc)
+	migrateDelegations(store, cdc)
+	return nil
+}
+
+func migrateValidators(store sdk.KVStore, cdc codec.BinaryCodec) {
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var validator types.Validator
+		cdc.MustUnmarshal(iterator.Value(), &validator)
+
+		validator.TotalLiquidShares = sdk.ZeroDec()
+		validator.ValidatorBondShares = sdk.ZeroDec()
+
+		store.Set(iterator.Key(), cdc.MustMarshal(&validator))
+	}
+}
+
+func migrateDelegations(store sdk.KVStore, cdc codec.BinaryCodec) {
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		cdc.MustUnmarshal(iterator.Value(), &delegation)
+
+		delegation.ValidatorBond = false
+
+		store.Set(iterator.Key(), cdc.MustMarshal(&delegation))
+	}
+}
@@ -0,0 +1,249 @@
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math_bits "math/bits"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// This file hand-implements the proto.Message wire encoding that protoc-gen-gogo
+// would otherwise generate for the LSM messages in msgs_lsm.go: there is no
+// .proto source for this extension, so Marshal/Unmarshal are written directly
+// against the gogoproto wire format instead.
+
+func init() {
+	proto.RegisterType((*MsgTokenizeShares)(nil), "cosmos.staking.v1beta1.MsgTokenizeShares")
+	proto.RegisterType((*MsgTokenizeSharesResponse)(nil), "cosmos.staking.v1beta1.MsgTokenizeSharesResponse")
+	proto.RegisterType((*MsgRedeemTokensForShares)(nil), "cosmos.staking.v1beta1.MsgRedeemTokensForShares")
+	proto.RegisterType((*MsgRedeemTokensForSharesResponse)(nil), "cosmos.staking.v1beta1.MsgRedeemTokensForSharesResponse")
+	proto.RegisterType((*MsgTransferTokenizeShareRecord)(nil), "cosmos.staking.v1beta1.MsgTransferTokenizeShareRecord")
+	proto.RegisterType((*MsgTransferTokenizeShareRecordResponse)(nil), "cosmos.staking.v1beta1.MsgTransferTokenizeShareRecordResponse")
+	proto.RegisterType((*MsgDisableTokenizeShares)(nil), "cosmos.staking.v1beta1.MsgDisableTokenizeShares")
+	proto.RegisterType((*MsgDisableTokenizeSharesResponse)(nil), "cosmos.staking.v1beta1.MsgDisableTokenizeSharesResponse")
+	proto.RegisterType((*MsgEnableTokenizeShares)(nil), "cosmos.staking.v1beta1.MsgEnableTokenizeShares")
+	proto.RegisterType((*MsgEnableTokenizeSharesResponse)(nil), "cosmos.staking.v1beta1.MsgEnableTokenizeSharesResponse")
+	proto.RegisterType((*MsgValidatorBond)(nil), "cosmos.staking.v1beta1.MsgValidatorBond")
+	proto.RegisterType((*MsgValidatorBondResponse)(nil), "cosmos.staking.v1beta1.MsgValidatorBondResponse")
+}
+
+// RegisterLSMLegacyAminoCodec extends the staking module's LegacyAmino
+// registration (RegisterLegacyAminoCodec in codec.go) with the LSM message
+// types, the same way LSMParamSetPairs extends Params.ParamSetPairs.
+func RegisterLSMLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgRedeemTokensForShares{}, "cosmos-sdk/MsgRedeemTokensForShares", nil)
+	cdc.RegisterConcrete(&MsgTransferTokenizeShareRecord{}, "cosmos-sdk/MsgTransferTokenizeShareRecord", nil)
+	cdc.RegisterConcrete(&MsgDisableTokenizeShares{}, "cosmos-sdk/MsgDisableTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgEnableTokenizeShares{}, "cosmos-sdk/MsgEnableTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgValidatorBond{}, "cosmos-sdk/MsgValidatorBond", nil)
+}
+
+// RegisterLSMInterfaces extends the staking module's interface registration
+// (RegisterInterfaces in codec.go) with the LSM sdk.Msg implementations.
+func RegisterLSMInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgTransferTokenizeShareRecord{},
+		&MsgDisableTokenizeShares{},
+		&MsgEnableTokenizeShares{},
+		&MsgValidatorBond{},
+	)
+}
+
+func sovLsm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func encodeVarintLsm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLsm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+// decodeVarintLsm reads a single varint-encoded field (tag or length) from
+// dAtA starting at iNdEx, shared by every Unmarshal method below.
+func decodeVarintLsm(dAtA []byte, iNdEx int) (uint64, int, error) {
+	var v uint64
+	l := len(dAtA)
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 64 {
+			return 0, 0, ErrIntOverflowLsm
+		}
+		if iNdEx >= l {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		b := dAtA[iNdEx]
+		iNdEx++
+		v |= uint64(b&0x7F) << shift
+		if b < 0x80 {
+			break
+		}
+	}
+	return v, iNdEx, nil
+}
+
+// decodeStringLsm reads a length-delimited string field starting at iNdEx.
+func decodeStringLsm(dAtA []byte, iNdEx int) (string, int, error) {
+	b, next, err := decodeBytesLsm(dAtA, iNdEx)
+	if err != nil {
+		return "", 0, err
+	}
+	return string(b), next, nil
+}
+
+// decodeBytesLsm reads a length-delimited byte field starting at iNdEx.
+func decodeBytesLsm(dAtA []byte, iNdEx int) ([]byte, int, error) {
+	length, next, err := decodeVarintLsm(dAtA, iNdEx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if int(length) < 0 {
+		return nil, 0, ErrInvalidLengthLsm
+	}
+	postIndex := next + int(length)
+	if postIndex < 0 {
+		return nil, 0, ErrInvalidLengthLsm
+	}
+	if postIndex > len(dAtA) {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	return dAtA[next:postIndex], postIndex, nil
+}
+
+// errInvalidWireType reports a field decoded with a wire type it cannot use.
+func errInvalidWireType(field string, wireType int) error {
+	return fmt.Errorf("proto: wrong wireType = %d for field %s", wireType, field)
+}
+
+// skipUnknownLsm skips the value of one unrecognized field whose tag (and
+// therefore wireType) has already been consumed by the caller, advancing
+// iNdEx past it and returning the new index, bounded by l.
+func skipUnknownLsm(dAtA []byte, iNdEx, l, wireType int) (int, error) {
+	switch wireType {
+	case 0:
+		_, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return 0, err
+		}
+		iNdEx = next
+	case 1:
+		iNdEx += 8
+	case 2:
+		length, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return 0, err
+		}
+		if int(length) < 0 {
+			return 0, ErrInvalidLengthLsm
+		}
+		iNdEx = next + int(length)
+	case 5:
+		iNdEx += 4
+	default:
+		return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+	}
+	if iNdEx < 0 {
+		return 0, ErrInvalidLengthLsm
+	}
+	if iNdEx > l {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return iNdEx, nil
+}
+
+// skipLsm advances past a single unknown field (of any wire type) so that
+// Unmarshal stays forward-compatible with fields added by a future version.
+func skipLsm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLsm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for {
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLsm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLsm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLsm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLsm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLsm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLsm = fmt.Errorf("proto: unexpected end of group")
+)
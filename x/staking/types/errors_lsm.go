@@ -0,0 +1,19 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// liquid staking module (LSM) errors.
+var (
+	ErrTokenizeSharesDisabled            = sdkerrors.Register(ModuleName, 80, "tokenize shares disabled for this delegator")
+	ErrTokenizeShareRecordNotFound       = sdkerrors.Register(ModuleName, 81, "tokenize share record not found")
+	ErrInvalidShareTokenDenom            = sdkerrors.Register(ModuleName, 82, "invalid share token denom")
+	ErrInsufficientValidatorBondShares   = sdkerrors.Register(ModuleName, 83, "insufficient validator bond shares to tokenize this amount")
+	ErrValidatorLiquidStakingCapExceeded = sdkerrors.Register(ModuleName, 84, "validator liquid staking cap exceeded")
+	ErrGlobalLiquidStakingCapExceeded    = sdkerrors.Register(ModuleName, 85, "global liquid staking cap exceeded")
+	ErrValidatorBondAlreadySet           = sdkerrors.Register(ModuleName, 86, "delegation is already flagged as a validator bond")
+)
+
+const (
+	EventTypeTokenizeShares     = "tokenize_shares"
+	AttributeKeyShareTokenDenom = "share_token_denom"
+)
@@ -0,0 +1,47 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName for the tokenize share records module account, suffixed per
+	// record so that each record gets its own module-controlled address.
+	TokenizeShareModuleAccountPrefix = "tokenizeshare"
+)
+
+var (
+	// TokenizeShareRecordPrefix is the key prefix for TokenizeShareRecord, keyed by record ID.
+	TokenizeShareRecordPrefix = []byte{0x70}
+
+	// TokenizeShareRecordOwnerPrefix is the key prefix for the owner -> record ID index.
+	TokenizeShareRecordOwnerPrefix = []byte{0x71}
+
+	// LastTokenizeShareRecordIDKey is the key for the last used TokenizeShareRecord id.
+	LastTokenizeShareRecordIDKey = []byte{0x72}
+
+	// TokenizeShareLockedKey is the key prefix tracking accounts that have locked
+	// their ability to tokenize shares via MsgDisableTokenizeShares.
+	TokenizeShareLockedKey = []byte{0x73}
+)
+
+// GetTokenizeShareRecordByIndexKey returns the key for a TokenizeShareRecord given its ID.
+func GetTokenizeShareRecordByIndexKey(recordID uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(recordID)...)
+}
+
+// GetTokenizeShareRecordsByOwnerPrefixKey returns the prefix used to iterate over
+// every TokenizeShareRecord owned by the given address.
+func GetTokenizeShareRecordsByOwnerPrefixKey(owner []byte) []byte {
+	return append(TokenizeShareRecordOwnerPrefix, owner...)
+}
+
+// GetTokenizeShareRecordsByOwnerKey returns the key mapping an owner/record-id pair
+// to the empty sentinel value used for the owner index.
+func GetTokenizeShareRecordsByOwnerKey(owner []byte, recordID uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerPrefixKey(owner), sdk.Uint64ToBigEndian(recordID)...)
+}
+
+// GetTokenizeShareLockedKey returns the key used to flag that an account has
+// disabled tokenization of its delegations.
+func GetTokenizeShareLockedKey(delegator []byte) []byte {
+	return append(TokenizeShareLockedKey, delegator...)
+}
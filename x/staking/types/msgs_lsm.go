@@ -0,0 +1,279 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// staking message types for the liquid staking module (LSM) extension.
+const (
+	TypeMsgTokenizeShares              = "tokenize_shares"
+	TypeMsgRedeemTokensForShares       = "redeem_tokens_for_shares"
+	TypeMsgTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+	TypeMsgDisableTokenizeShares       = "disable_tokenize_shares"
+	TypeMsgEnableTokenizeShares        = "enable_tokenize_shares"
+	TypeMsgValidatorBond               = "validator_bond"
+)
+
+// MsgTokenizeShares converts a portion of a delegation into a transferable
+// share token denominated "{validator}/{recordID}".
+type MsgTokenizeShares struct {
+	DelegatorAddress    string   `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress    string   `json:"validator_address" yaml:"validator_address"`
+	Amount              sdk.Coin `json:"amount" yaml:"amount"`
+	TokenizedShareOwner string   `json:"tokenized_share_owner" yaml:"tokenized_share_owner"`
+}
+
+func NewMsgTokenizeShares(delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    validator.String(),
+		Amount:              amount,
+		TokenizedShareOwner: owner.String(),
+	}
+}
+
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+func (msg MsgTokenizeShares) Type() string  { return TypeMsgTokenizeShares }
+
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg MsgTokenizeShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid delegator address")
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid validator address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+		return sdkerrors.Wrap(err, "invalid tokenized share owner address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid tokenize amount")
+	}
+	return nil
+}
+
+func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
+func (m *MsgTokenizeShares) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgTokenizeShares) ProtoMessage()    {}
+
+// MsgTokenizeSharesResponse returns the share token minted for the owner.
+type MsgTokenizeSharesResponse struct {
+	Amount sdk.Coin `json:"amount" yaml:"amount"`
+}
+
+func (m *MsgTokenizeSharesResponse) Reset()         { *m = MsgTokenizeSharesResponse{} }
+func (m *MsgTokenizeSharesResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgTokenizeSharesResponse) ProtoMessage()    {}
+
+// MsgRedeemTokensForShares burns a share token and restores the corresponding
+// delegation directly to the owner, removing (or shrinking) the backing
+// TokenizeShareRecord.
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress string   `json:"delegator_address" yaml:"delegator_address"`
+	Amount           sdk.Coin `json:"amount" yaml:"amount"`
+}
+
+func NewMsgRedeemTokensForShares(delegator sdk.AccAddress, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{DelegatorAddress: delegator.String(), Amount: amount}
+}
+
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+func (msg MsgRedeemTokensForShares) Type() string  { return TypeMsgRedeemTokensForShares }
+
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg MsgRedeemTokensForShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid delegator address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid redeem amount")
+	}
+	return nil
+}
+
+func (m *MsgRedeemTokensForShares) Reset()         { *m = MsgRedeemTokensForShares{} }
+func (m *MsgRedeemTokensForShares) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgRedeemTokensForShares) ProtoMessage()    {}
+
+// MsgRedeemTokensForSharesResponse returns the delegation value restored to
+// the redeemer, after any pro-rata slashing loss.
+type MsgRedeemTokensForSharesResponse struct {
+	Amount sdk.Coin `json:"amount" yaml:"amount"`
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Reset()         { *m = MsgRedeemTokensForSharesResponse{} }
+func (m *MsgRedeemTokensForSharesResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgRedeemTokensForSharesResponse) ProtoMessage()    {}
+
+// MsgTransferTokenizeShareRecord transfers ownership of a TokenizeShareRecord
+// (and thus the right to withdraw the rewards of the underlying delegation)
+// to a new owner. Redeeming share tokens does not depend on record ownership.
+type MsgTransferTokenizeShareRecord struct {
+	TokenizeShareRecordId uint64 `json:"tokenize_share_record_id" yaml:"tokenize_share_record_id"`
+	Sender                string `json:"sender" yaml:"sender"`
+	NewOwner              string `json:"new_owner" yaml:"new_owner"`
+}
+
+func (msg MsgTransferTokenizeShareRecord) Route() string { return RouterKey }
+func (msg MsgTransferTokenizeShareRecord) Type() string  { return TypeMsgTransferTokenizeShareRecord }
+
+func (msg MsgTransferTokenizeShareRecord) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgTransferTokenizeShareRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.Wrap(err, "invalid new owner address")
+	}
+	return nil
+}
+
+func (m *MsgTransferTokenizeShareRecord) Reset()         { *m = MsgTransferTokenizeShareRecord{} }
+func (m *MsgTransferTokenizeShareRecord) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgTransferTokenizeShareRecord) ProtoMessage()    {}
+
+// MsgTransferTokenizeShareRecordResponse is empty; the transfer either
+// succeeds or returns an error.
+type MsgTransferTokenizeShareRecordResponse struct{}
+
+func (m *MsgTransferTokenizeShareRecordResponse) Reset() {
+	*m = MsgTransferTokenizeShareRecordResponse{}
+}
+func (m *MsgTransferTokenizeShareRecordResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgTransferTokenizeShareRecordResponse) ProtoMessage()    {}
+
+// MsgDisableTokenizeShares locks an account's delegations from being
+// tokenized via MsgTokenizeShares.
+type MsgDisableTokenizeShares struct {
+	DelegatorAddress string `json:"delegator_address" yaml:"delegator_address"`
+}
+
+func (msg MsgDisableTokenizeShares) Route() string { return RouterKey }
+func (msg MsgDisableTokenizeShares) Type() string  { return TypeMsgDisableTokenizeShares }
+
+func (msg MsgDisableTokenizeShares) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg MsgDisableTokenizeShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid delegator address")
+	}
+	return nil
+}
+
+func (m *MsgDisableTokenizeShares) Reset()         { *m = MsgDisableTokenizeShares{} }
+func (m *MsgDisableTokenizeShares) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgDisableTokenizeShares) ProtoMessage()    {}
+
+// MsgDisableTokenizeSharesResponse is empty; the lock either succeeds or
+// returns an error.
+type MsgDisableTokenizeSharesResponse struct{}
+
+func (m *MsgDisableTokenizeSharesResponse) Reset()         { *m = MsgDisableTokenizeSharesResponse{} }
+func (m *MsgDisableTokenizeSharesResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgDisableTokenizeSharesResponse) ProtoMessage()    {}
+
+// MsgEnableTokenizeShares reverses a prior MsgDisableTokenizeShares.
+type MsgEnableTokenizeShares struct {
+	DelegatorAddress string `json:"delegator_address" yaml:"delegator_address"`
+}
+
+func (msg MsgEnableTokenizeShares) Route() string { return RouterKey }
+func (msg MsgEnableTokenizeShares) Type() string  { return TypeMsgEnableTokenizeShares }
+
+func (msg MsgEnableTokenizeShares) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg MsgEnableTokenizeShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid delegator address")
+	}
+	return nil
+}
+
+func (m *MsgEnableTokenizeShares) Reset()         { *m = MsgEnableTokenizeShares{} }
+func (m *MsgEnableTokenizeShares) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgEnableTokenizeShares) ProtoMessage()    {}
+
+// MsgEnableTokenizeSharesResponse is empty; the unlock either succeeds or
+// returns an error.
+type MsgEnableTokenizeSharesResponse struct{}
+
+func (m *MsgEnableTokenizeSharesResponse) Reset()         { *m = MsgEnableTokenizeSharesResponse{} }
+func (m *MsgEnableTokenizeSharesResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgEnableTokenizeSharesResponse) ProtoMessage()    {}
+
+// MsgValidatorBond marks a delegation as a validator's own self-bond for the
+// purposes of the ValidatorBondFactor liquid staking cap; validator-bonded
+// delegations are never eligible for tokenization.
+type MsgValidatorBond struct {
+	DelegatorAddress string `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress string `json:"validator_address" yaml:"validator_address"`
+}
+
+func (msg MsgValidatorBond) Route() string { return RouterKey }
+func (msg MsgValidatorBond) Type() string  { return TypeMsgValidatorBond }
+
+func (msg MsgValidatorBond) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg MsgValidatorBond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid delegator address")
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid validator address")
+	}
+	return nil
+}
+
+func (m *MsgValidatorBond) Reset()         { *m = MsgValidatorBond{} }
+func (m *MsgValidatorBond) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgValidatorBond) ProtoMessage()    {}
+
+// MsgValidatorBondResponse is empty; the flag either gets set or an error is
+// returned.
+type MsgValidatorBondResponse struct{}
+
+func (m *MsgValidatorBondResponse) Reset()         { *m = MsgValidatorBondResponse{} }
+func (m *MsgValidatorBondResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgValidatorBondResponse) ProtoMessage()    {}
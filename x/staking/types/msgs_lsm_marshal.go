@@ -0,0 +1,778 @@
+package types
+
+import (
+	io "io"
+)
+
+// MsgTokenizeShares
+
+func (m *MsgTokenizeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if len(m.TokenizedShareOwner) > 0 {
+		i -= len(m.TokenizedShareOwner)
+		copy(dAtA[i:], m.TokenizedShareOwner)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.TokenizedShareOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLsm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLsm(uint64(l))
+	l = len(m.TokenizedShareOwner)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireType("DelegatorAddress", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.DelegatorAddress, iNdEx = s, next
+		case 2:
+			if wireType != 2 {
+				return errInvalidWireType("ValidatorAddress", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ValidatorAddress, iNdEx = s, next
+		case 3:
+			if wireType != 2 {
+				return errInvalidWireType("Amount", wireType)
+			}
+			b, next, err := decodeBytesLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if err := m.Amount.Unmarshal(b); err != nil {
+				return err
+			}
+			iNdEx = next
+		case 4:
+			if wireType != 2 {
+				return errInvalidWireType("TokenizedShareOwner", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.TokenizedShareOwner, iNdEx = s, next
+		default:
+			iNdEx, err = skipUnknownLsm(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// MsgTokenizeSharesResponse
+
+func (m *MsgTokenizeSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+	if err != nil {
+		return 0, err
+	}
+	i -= size
+	i = encodeVarintLsm(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	l := m.Amount.Size()
+	n += 1 + l + sovLsm(uint64(l))
+	return n
+}
+
+func (m *MsgTokenizeSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireType("Amount", wireType)
+			}
+			b, next, err := decodeBytesLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if err := m.Amount.Unmarshal(b); err != nil {
+				return err
+			}
+			iNdEx = next
+		default:
+			iNdEx, err = skipUnknownLsm(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares
+
+func (m *MsgRedeemTokensForShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLsm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	l := len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLsm(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemTokensForShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireType("DelegatorAddress", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.DelegatorAddress, iNdEx = s, next
+		case 2:
+			if wireType != 2 {
+				return errInvalidWireType("Amount", wireType)
+			}
+			b, next, err := decodeBytesLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if err := m.Amount.Unmarshal(b); err != nil {
+				return err
+			}
+			iNdEx = next
+		default:
+			iNdEx, err = skipUnknownLsm(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// MsgRedeemTokensForSharesResponse
+
+func (m *MsgRedeemTokensForSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+	if err != nil {
+		return 0, err
+	}
+	i -= size
+	i = encodeVarintLsm(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	l := m.Amount.Size()
+	n += 1 + l + sovLsm(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireType("Amount", wireType)
+			}
+			b, next, err := decodeBytesLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if err := m.Amount.Unmarshal(b); err != nil {
+				return err
+			}
+			iNdEx = next
+		default:
+			iNdEx, err = skipUnknownLsm(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// MsgTransferTokenizeShareRecord
+
+func (m *MsgTransferTokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TokenizeShareRecordId != 0 {
+		i = encodeVarintLsm(dAtA, i, uint64(m.TokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.TokenizeShareRecordId != 0 {
+		n += 1 + sovLsm(uint64(m.TokenizeShareRecordId))
+	}
+	l := len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferTokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return errInvalidWireType("TokenizeShareRecordId", wireType)
+			}
+			v, next, err := decodeVarintLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.TokenizeShareRecordId, iNdEx = v, next
+		case 2:
+			if wireType != 2 {
+				return errInvalidWireType("Sender", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Sender, iNdEx = s, next
+		case 3:
+			if wireType != 2 {
+				return errInvalidWireType("NewOwner", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.NewOwner, iNdEx = s, next
+		default:
+			iNdEx, err = skipUnknownLsm(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// MsgTransferTokenizeShareRecordResponse and the Disable/Enable/ValidatorBond
+// responses below are entirely empty; MsgDisableTokenizeShares and
+// MsgEnableTokenizeShares carry a single delegator address field (field 1);
+// MsgValidatorBond carries a delegator address (field 1) and a validator
+// address (field 2).
+
+func (m *MsgTransferTokenizeShareRecordResponse) Marshal() (dAtA []byte, err error) {
+	return []byte{}, nil
+}
+func (m *MsgTransferTokenizeShareRecordResponse) MarshalTo(dAtA []byte) (int, error) { return 0, nil }
+func (m *MsgTransferTokenizeShareRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return len(dAtA), nil
+}
+func (m *MsgTransferTokenizeShareRecordResponse) Size() (n int) { return 0 }
+func (m *MsgTransferTokenizeShareRecordResponse) Unmarshal(dAtA []byte) error {
+	if len(dAtA) == 0 {
+		return nil
+	}
+	_, err := skipLsm(dAtA)
+	return err
+}
+
+func marshalSingleAddressMsg(addr string) (dAtA []byte, err error) {
+	size := 0
+	if l := len(addr); l > 0 {
+		size = 1 + l + sovLsm(uint64(l))
+	}
+	dAtA = make([]byte, size)
+	i := size
+	if l := len(addr); l > 0 {
+		i -= l
+		copy(dAtA[i:], addr)
+		i = encodeVarintLsm(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0xa
+	}
+	return dAtA, nil
+}
+
+func unmarshalSingleAddressMsg(dAtA []byte) (addr string, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return "", err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return "", errInvalidWireType("DelegatorAddress", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return "", err
+			}
+			addr, iNdEx = s, next
+		default:
+			iNdEx, err = skipUnknownLsm(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+	if iNdEx > l {
+		return "", io.ErrUnexpectedEOF
+	}
+	return addr, nil
+}
+
+func (m *MsgDisableTokenizeShares) Marshal() (dAtA []byte, err error) {
+	return marshalSingleAddressMsg(m.DelegatorAddress)
+}
+func (m *MsgDisableTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	b, err := m.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return copy(dAtA, b), nil
+}
+func (m *MsgDisableTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	b, err := m.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return copy(dAtA[len(dAtA)-len(b):], b), nil
+}
+func (m *MsgDisableTokenizeShares) Size() (n int) {
+	if l := len(m.DelegatorAddress); l > 0 {
+		n = 1 + l + sovLsm(uint64(l))
+	}
+	return n
+}
+func (m *MsgDisableTokenizeShares) Unmarshal(dAtA []byte) error {
+	addr, err := unmarshalSingleAddressMsg(dAtA)
+	if err != nil {
+		return err
+	}
+	m.DelegatorAddress = addr
+	return nil
+}
+
+func (m *MsgEnableTokenizeShares) Marshal() (dAtA []byte, err error) {
+	return marshalSingleAddressMsg(m.DelegatorAddress)
+}
+func (m *MsgEnableTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	b, err := m.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return copy(dAtA, b), nil
+}
+func (m *MsgEnableTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	b, err := m.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return copy(dAtA[len(dAtA)-len(b):], b), nil
+}
+func (m *MsgEnableTokenizeShares) Size() (n int) {
+	if l := len(m.DelegatorAddress); l > 0 {
+		n = 1 + l + sovLsm(uint64(l))
+	}
+	return n
+}
+func (m *MsgEnableTokenizeShares) Unmarshal(dAtA []byte) error {
+	addr, err := unmarshalSingleAddressMsg(dAtA)
+	if err != nil {
+		return err
+	}
+	m.DelegatorAddress = addr
+	return nil
+}
+
+func (m *MsgDisableTokenizeSharesResponse) Marshal() (dAtA []byte, err error)  { return []byte{}, nil }
+func (m *MsgDisableTokenizeSharesResponse) MarshalTo(dAtA []byte) (int, error) { return 0, nil }
+func (m *MsgDisableTokenizeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return len(dAtA), nil
+}
+func (m *MsgDisableTokenizeSharesResponse) Size() (n int) { return 0 }
+func (m *MsgDisableTokenizeSharesResponse) Unmarshal(dAtA []byte) error {
+	if len(dAtA) == 0 {
+		return nil
+	}
+	_, err := skipLsm(dAtA)
+	return err
+}
+
+func (m *MsgEnableTokenizeSharesResponse) Marshal() (dAtA []byte, err error)  { return []byte{}, nil }
+func (m *MsgEnableTokenizeSharesResponse) MarshalTo(dAtA []byte) (int, error) { return 0, nil }
+func (m *MsgEnableTokenizeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return len(dAtA), nil
+}
+func (m *MsgEnableTokenizeSharesResponse) Size() (n int) { return 0 }
+func (m *MsgEnableTokenizeSharesResponse) Unmarshal(dAtA []byte) error {
+	if len(dAtA) == 0 {
+		return nil
+	}
+	_, err := skipLsm(dAtA)
+	return err
+}
+
+// MsgValidatorBond
+
+func (m *MsgValidatorBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValidatorBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidatorBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgValidatorBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	l := len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValidatorBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, next, err := decodeVarintLsm(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return errInvalidWireType("DelegatorAddress", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.DelegatorAddress, iNdEx = s, next
+		case 2:
+			if wireType != 2 {
+				return errInvalidWireType("ValidatorAddress", wireType)
+			}
+			s, next, err := decodeStringLsm(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ValidatorAddress, iNdEx = s, next
+		default:
+			iNdEx, err = skipUnknownLsm(dAtA, iNdEx, l, wireType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgValidatorBondResponse) Marshal() (dAtA []byte, err error)  { return []byte{}, nil }
+func (m *MsgValidatorBondResponse) MarshalTo(dAtA []byte) (int, error) { return 0, nil }
+func (m *MsgValidatorBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return len(dAtA), nil
+}
+func (m *MsgValidatorBondResponse) Size() (n int) { return 0 }
+func (m *MsgValidatorBondResponse) Unmarshal(dAtA []byte) error {
+	if len(dAtA) == 0 {
+		return nil
+	}
+	_, err := skipLsm(dAtA)
+	return err
+}
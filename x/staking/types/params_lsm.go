@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Liquid staking parameter keys, stored alongside the existing staking Params
+// in the x/params subspace.
+var (
+	KeyValidatorBondFactor       = []byte("ValidatorBondFactor")
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+)
+
+// DefaultValidatorBondFactor is the default multiple of a validator's own
+// bonded (non-tokenizable) shares that may back liquid staked shares; -1
+// disables the validator bond requirement entirely.
+var DefaultValidatorBondFactor = sdk.NewDec(-1)
+
+// DefaultGlobalLiquidStakingCap is the default fraction of total bonded
+// tokens, network-wide, that may be liquid staked.
+var DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+// DefaultValidatorLiquidStakingCap is the default fraction of a single
+// validator's total delegation that may be liquid staked.
+var DefaultValidatorLiquidStakingCap = sdk.OneDec()
+
+// LSMParamSetPairs extends the staking ParamSetPairs returned by
+// Params.ParamSetPairs with the three liquid staking caps.
+func LSMParamSetPairs(p *Params) paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+	}
+}
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.Equal(sdk.NewDec(-1)) && !v.IsPositive() {
+		return fmt.Errorf("validator bond factor must be -1 (disabled) or positive: %s", v)
+	}
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap cannot exceed 1: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	fmt "fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareLocker is implemented by vesting accounts (see
+// x/auth/vesting/types.DelegableContinuousVestingAccount and its Periodic
+// and Delayed variants) that allow delegating unvested tokens but must
+// still block the resulting delegation from being tokenized via
+// MsgTokenizeShares until the underlying coins vest.
+type TokenizeShareLocker interface {
+	LockedForTokenization(blockTime time.Time) sdk.Coins
+}
+
+// TokenizeShareRecord ties together a share-token denomination with the
+// module account that holds the underlying delegation on behalf of the
+// owners of that share token.
+type TokenizeShareRecord struct {
+	Id            uint64 `json:"id" yaml:"id"`
+	Owner         string `json:"owner" yaml:"owner"`
+	ModuleAccount string `json:"module_account" yaml:"module_account"`
+	Validator     string `json:"validator" yaml:"validator"`
+}
+
+// NewTokenizeShareRecord returns a new TokenizeShareRecord for the given id.
+func NewTokenizeShareRecord(id uint64, owner, validator string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: GetTokenizeShareRecordModuleAddress(id).String(),
+		Validator:     validator,
+	}
+}
+
+// GetTokenizeShareRecordModuleAddress derives the module-controlled account
+// that holds the delegation backing the TokenizeShareRecord with the given id.
+func GetTokenizeShareRecordModuleAddress(id uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s-%d", TokenizeShareModuleAccountPrefix, id))
+}
+
+// GetShareTokenDenom returns the denomination of the transferable share token
+// that represents the tokenized portion of a delegation to validator,
+// e.g. "cosmosvaloper1.../1".
+func GetShareTokenDenom(validatorAddr string, recordID uint64) string {
+	return fmt.Sprintf("%s/%d", validatorAddr, recordID)
+}
+
+// ParseShareTokenDenom splits a share token denom of the form
+// "{valoper}/{recordID}" back into its TokenizeShareRecord id and validator
+// operator address.
+func ParseShareTokenDenom(denom string) (recordID uint64, validatorAddr string, err error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return 0, "", fmt.Errorf("share token denom %q is not of the form {valoper}/{recordID}", denom)
+	}
+
+	recordID, err = strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("share token denom %q has an invalid record id: %w", denom, err)
+	}
+
+	return recordID, parts[0], nil
+}